@@ -2,10 +2,16 @@ package charger
 
 import (
 	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"os"
 	"strings"
 	"sync"
 	"time"
@@ -14,15 +20,20 @@ import (
 	"github.com/evcc-io/evcc/cmd/shutdown"
 	"github.com/evcc-io/evcc/util"
 	"github.com/evcc-io/evcc/util/request"
-	"github.com/evcc-io/evcc/util/transport"
 )
 
 type AlfenHttp struct {
 	*request.Helper
 	log            *util.Logger
+	ctx            context.Context
+	cancel         context.CancelFunc
 	uri            string
 	password       string
-	mu             sync.Mutex
+	connector      int
+	certAuth       bool
+	session        *alfenSession
+	readDeadline   deadlineTimer
+	writeDeadline  deadlineTimer
 	getPropertiesG func() (*Properties, error)
 }
 
@@ -31,40 +42,313 @@ func init() {
 }
 
 func NewAlfenHttpFromConfig(other map[string]interface{}) (api.Charger, error) {
-	var cc struct {
-		Uri      string
-		Password string
+	cc := struct {
+		Uri                string
+		Password           string
+		Connector          int
+		CACert             string
+		InsecureSkipVerify bool
+		ClientCert         string
+		ClientKey          string
+	}{
+		Connector: 1,
 	}
 
 	if err := util.DecodeOther(other, &cc); err != nil {
 		return nil, err
 	}
 
-	c, err := NewAlfenHttp(util.DefaultScheme(cc.Uri, "https"), cc.Password)
+	tlsConfig, certAuth, err := buildAlfenTLSConfig(cc.CACert, cc.InsecureSkipVerify, cc.ClientCert, cc.ClientKey)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := NewAlfenHttp(util.DefaultScheme(cc.Uri, "https"), cc.Password, cc.Connector, tlsConfig, certAuth)
 
 	return c, err
 }
 
-func NewAlfenHttp(uri string, password string) (*AlfenHttp, error) {
+// buildAlfenTLSConfig assembles the tls.Config used to talk to the Alfen.
+// The wallbox ships with a self-signed certificate - pin it via caCert
+// rather than falling back to insecureSkipVerify. If a client certificate
+// is given it is presented for mTLS auth and certAuth is returned true so
+// login can skip the password POST.
+func buildAlfenTLSConfig(caCert string, insecureSkipVerify bool, clientCert, clientKey string) (*tls.Config, bool, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: insecureSkipVerify}
+
+	if caCert != "" {
+		pem, err := loadAlfenPEM(caCert)
+		if err != nil {
+			return nil, false, fmt.Errorf("ca cert: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, false, fmt.Errorf("ca cert: invalid PEM data")
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	if clientCert == "" && clientKey == "" {
+		return tlsConfig, false, nil
+	}
+
+	certPEM, err := loadAlfenPEM(clientCert)
+	if err != nil {
+		return nil, false, fmt.Errorf("client cert: %w", err)
+	}
+
+	keyPEM, err := loadAlfenPEM(clientKey)
+	if err != nil {
+		return nil, false, fmt.Errorf("client key: %w", err)
+	}
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, false, fmt.Errorf("client cert: %w", err)
+	}
+
+	tlsConfig.Certificates = []tls.Certificate{cert}
+
+	return tlsConfig, true, nil
+}
+
+// loadAlfenPEM reads PEM-encoded data from a file, falling back to treating
+// s as inline PEM content if it isn't a readable file path.
+func loadAlfenPEM(s string) ([]byte, error) {
+	if b, err := os.ReadFile(s); err == nil {
+		return b, nil
+	}
+
+	return []byte(s), nil
+}
+
+// defaultRequestDeadline bounds a single get/post round-trip when the
+// caller hasn't set an explicit deadline via SetReadDeadline/
+// SetWriteDeadline, so a hung wallbox is always cancelled well before
+// request.Timeout instead of only on Shutdown.
+const defaultRequestDeadline = 10 * time.Second
+
+// deadlineTimer derives a context that is cancelled once a deadline
+// elapses, mirroring net.Conn's SetDeadline semantics for a plain
+// http.Request. Internally this relies on context.WithDeadline's own
+// time.AfterFunc, so a hung wallbox cancels the in-flight request instead
+// of wedging the caller until request.Timeout.
+type deadlineTimer struct {
+	mu       sync.Mutex
+	deadline time.Time
+}
+
+func (d *deadlineTimer) set(t time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.deadline = t
+}
+
+// arm returns an independent context/cancel pair for a single request, so
+// that concurrent requests sharing the same deadlineTimer don't cancel one
+// another. The caller is responsible for eventually calling cancel. Absent
+// an explicit deadline, it falls back to defaultRequestDeadline from now
+// rather than leaving the request uncancellable.
+func (d *deadlineTimer) arm(parent context.Context) (context.Context, context.CancelFunc) {
+	d.mu.Lock()
+	deadline := d.deadline
+	d.mu.Unlock()
+
+	if deadline.IsZero() {
+		deadline = time.Now().Add(defaultRequestDeadline)
+	}
+
+	return context.WithDeadline(parent, deadline)
+}
+
+// alfenSession is shared between the AlfenHttp instances driving the two
+// sockets of a dual-socket (Double/Twin) Alfen, since the wallbox only
+// permits a single concurrent admin session and the instances would
+// otherwise log each other out. refs tracks how many AlfenHttp instances
+// currently hold it - including ones still attempting login - so
+// releaseAlfenSession can evict it from the registry once none remain,
+// instead of leaking one entry per distinct uri for the process lifetime.
+// connectors instead tracks only instances that finished logging in
+// successfully and haven't yet torn down; it's a separate, narrower count
+// used purely for Shutdown's sibling-still-live arbitration check below,
+// not a substitute for refs.
+//
+// maxStationCurrent and installationMaxAllowedPhases are single
+// station-wide registers rather than per-socket ones, so
+// requestedCurrent/requestedPhases track what each live connector last
+// asked for; writeCurrent/writePhases arbitrate the two sockets' demands
+// into the single value actually written to the device, so one socket
+// backing off or disabling doesn't cut the other one off. Recording the
+// request and performing the write both happen under arbMu so a slower
+// write from one connector can't land after a newer one and stomp it back
+// down with a stale combined value.
+type alfenSession struct {
+	mu   sync.Mutex
+	refs int
+
+	arbMu            sync.Mutex
+	connectors       map[int]bool
+	requestedCurrent map[int]int64
+	requestedPhases  map[int]int
+}
+
+var (
+	alfenSessionsMu sync.Mutex
+	alfenSessions   = make(map[string]*alfenSession)
+)
+
+// sharedAlfenSession returns the session shared by all AlfenHttp instances
+// for the given uri, creating it on first use.
+func sharedAlfenSession(uri string) *alfenSession {
+	alfenSessionsMu.Lock()
+	defer alfenSessionsMu.Unlock()
+
+	s, ok := alfenSessions[uri]
+	if !ok {
+		s = &alfenSession{
+			connectors:       make(map[int]bool),
+			requestedCurrent: make(map[int]int64),
+			requestedPhases:  make(map[int]int),
+		}
+		alfenSessions[uri] = s
+	}
+
+	s.refs++
+
+	return s
+}
+
+// registerConnector marks connector as live once its AlfenHttp has
+// successfully logged in, so unregisterConnector can tell Shutdown whether
+// a sibling socket is still active.
+func (s *alfenSession) registerConnector(connector int) {
+	s.arbMu.Lock()
+	defer s.arbMu.Unlock()
+
+	s.connectors[connector] = true
+}
+
+// unregisterConnector drops connector's registration and any pending
+// arbitration state for it, returning whether another connector is still
+// live on this session.
+func (s *alfenSession) unregisterConnector(connector int) bool {
+	s.arbMu.Lock()
+	defer s.arbMu.Unlock()
+
+	delete(s.connectors, connector)
+	delete(s.requestedCurrent, connector)
+	delete(s.requestedPhases, connector)
+
+	return len(s.connectors) > 0
+}
+
+// writeCurrent records connector's requested station current limit,
+// arbitrates it against the other live connectors' last requests - the
+// max across all of them, so a socket lowering its own demand (or
+// disabling) doesn't drag down a sibling socket that's still charging -
+// and invokes write with the combined value. Recording the request and
+// writing it happen under the same arbMu critical section, so a slower
+// connector's write can never land after a newer one and stomp it back
+// down with a stale combined value.
+func (s *alfenSession) writeCurrent(connector int, current int64, write func(combined int64) error) error {
+	s.arbMu.Lock()
+	defer s.arbMu.Unlock()
+
+	s.requestedCurrent[connector] = current
+
+	combined := current
+	for _, c := range s.requestedCurrent {
+		if c > combined {
+			combined = c
+		}
+	}
+
+	return write(combined)
+}
+
+// writePhases records connector's requested installation phase count,
+// arbitrates it against the other live connectors' last requests - the
+// max across all of them, so a socket switching down to 1p doesn't force
+// a sibling socket that still needs 3p down with it - and invokes write
+// with the combined value, under the same arbMu critical section as the
+// record, for the same reason as writeCurrent.
+func (s *alfenSession) writePhases(connector int, phases int, write func(combined int) error) error {
+	s.arbMu.Lock()
+	defer s.arbMu.Unlock()
+
+	s.requestedPhases[connector] = phases
+
+	combined := phases
+	for _, p := range s.requestedPhases {
+		if p > combined {
+			combined = p
+		}
+	}
+
+	return write(combined)
+}
+
+// releaseAlfenSession drops the caller's reference to uri's shared session,
+// evicting it from the registry once no AlfenHttp instance holds it.
+func releaseAlfenSession(uri string) {
+	alfenSessionsMu.Lock()
+	defer alfenSessionsMu.Unlock()
+
+	s, ok := alfenSessions[uri]
+	if !ok {
+		return
+	}
+
+	s.refs--
+	if s.refs <= 0 {
+		delete(alfenSessions, uri)
+	}
+}
+
+func NewAlfenHttp(uri string, password string, connector int, tlsConfig *tls.Config, certAuth bool) (*AlfenHttp, error) {
+	if connector != 1 && connector != 2 {
+		return nil, fmt.Errorf("invalid connector: %d", connector)
+	}
+
 	log := util.NewLogger("alfenhttp").Redact(password)
+	uri = strings.TrimRight(uri, "/")
+	ctx, cancel := context.WithCancel(context.Background())
 
 	c := &AlfenHttp{
-		Helper:   newInsecureHelper(log),
-		log:      log,
-		uri:      strings.TrimRight(uri, "/"),
-		password: password,
+		Helper:    newAlfenHelper(log, tlsConfig),
+		log:       log,
+		ctx:       ctx,
+		cancel:    cancel,
+		uri:       uri,
+		password:  password,
+		connector: connector,
+		certAuth:  certAuth,
+		session:   sharedAlfenSession(uri),
 	}
 
 	c.getPropertiesG = util.Cached(func() (*Properties, error) {
 		return c.getProperties()
 	}, time.Second*5)
 
-	shutdown.Register(c.Shutdown)
+	c.session.mu.Lock()
+	err := c.login()
+	c.session.mu.Unlock()
 
-	if err := c.login(); err != nil {
+	if err != nil {
+		c.cancel()
+		releaseAlfenSession(uri)
 		return nil, err
 	}
 
+	c.session.registerConnector(connector)
+
+	// only register the shutdown hook (which releases the session) once
+	// construction fully succeeded, so a failed/discarded instance can't
+	// have its session released a second time by a later process exit
+	shutdown.Register(c.Shutdown)
+
 	return c, nil
 }
 
@@ -85,38 +369,93 @@ const (
 	loadBalancingForcedOff = 36
 )
 
+// station-level properties, shared by both sockets of a dual-socket Alfen
 const (
-	bootups                    = "2056_0"
-	bootReason                 = "2057_0"
-	uptime                     = "2060_0"
-	maxStationCurrent          = "2062_0"
-	voltageL1Socket1           = "2221_3"
-	voltageL2Socket1           = "2221_4"
-	voltageL3Socket1           = "2221_5"
-	activePowerTotal           = "2221_16"
-	meterReadingSocket1        = "2221_22"
-	currentL1Socket1           = "2221_A"
-	currentL2Socket1           = "2221_B"
-	currentL3Socket1           = "2221_C"
-	state                      = "2501_2"
-	connector1MaxAllowedPhases = "312E_0"
+	bootups           = "2056_0"
+	bootReason        = "2057_0"
+	uptime            = "2060_0"
+	maxStationCurrent = "2062_0"
+	activePowerTotal  = "2221_16"
 )
 
-var readProps = []string{
-	bootups,
-	bootReason,
-	uptime,
-	maxStationCurrent,
-	voltageL1Socket1,
-	voltageL2Socket1,
-	voltageL3Socket1,
-	activePowerTotal,
-	meterReadingSocket1,
-	currentL1Socket1,
-	currentL2Socket1,
-	currentL3Socket1,
-	state,
-	connector1MaxAllowedPhases,
+// per-socket properties, indexed by connector (1 or 2)
+type socketProps struct {
+	state            string
+	voltageL1        string
+	voltageL2        string
+	voltageL3        string
+	currentL1        string
+	currentL2        string
+	currentL3        string
+	meterReading     string
+	maxAllowedPhases string
+	tag              string // last used RFID/authorization tag for this socket
+}
+
+var connectorProps = map[int]socketProps{
+	1: {
+		state:            "2501_2",
+		voltageL1:        "2221_3",
+		voltageL2:        "2221_4",
+		voltageL3:        "2221_5",
+		currentL1:        "2221_A",
+		currentL2:        "2221_B",
+		currentL3:        "2221_C",
+		meterReading:     "2221_22",
+		maxAllowedPhases: "312E_0",
+		tag:              "2053_0",
+	},
+	2: {
+		state:            "2501_3",
+		voltageL1:        "2221_D",
+		voltageL2:        "2221_E",
+		voltageL3:        "2221_F",
+		currentL1:        "2221_10",
+		currentL2:        "2221_11",
+		currentL3:        "2221_12",
+		meterReading:     "2221_23",
+		maxAllowedPhases: "312F_0",
+		// 2053_0 is confirmed as the last-used tag for socket 1; no
+		// second-socket tag property is documented, so leave this unset
+		// rather than guess an id that could make the whole batched
+		// property read fail on real hardware. Identify() treats an empty
+		// tag as "unsupported on this connector" and returns "" instead of
+		// querying a property that may not exist.
+		tag: "",
+	},
+}
+
+func (c *AlfenHttp) props() socketProps {
+	return connectorProps[c.connector]
+}
+
+func (c *AlfenHttp) readProps() []string {
+	p := c.props()
+
+	props := []string{
+		bootups,
+		bootReason,
+		uptime,
+		maxStationCurrent,
+		p.voltageL1,
+		p.voltageL2,
+		p.voltageL3,
+		activePowerTotal,
+		p.meterReading,
+		p.currentL1,
+		p.currentL2,
+		p.currentL3,
+		p.state,
+		p.maxAllowedPhases,
+	}
+
+	// p.tag may be unset for a connector whose tag property id isn't
+	// confirmed - omit it rather than querying an id that may not exist
+	if p.tag != "" {
+		props = append(props, p.tag)
+	}
+
+	return props
 }
 
 const (
@@ -134,18 +473,48 @@ const (
 const alfenContentType = "alfen/json; charset=utf-8"
 
 func (c *AlfenHttp) Shutdown() {
-	c.log.DEBUG.Print("resetting charger to 3p")
-	c.Phases1p3p(3)
-	c.log.DEBUG.Printf("resetting charger current to %dA", maxCurrent)
-	c.MaxCurrent(maxCurrent)
+	if siblingLive := c.session.unregisterConnector(c.connector); siblingLive {
+		// maxStationCurrent/installationMaxAllowedPhases and the login
+		// session are shared station-wide - resetting or logging out here
+		// would pull them out from under the sibling connector's still
+		// active session, so leave them alone and let it keep driving them
+		c.log.DEBUG.Print("sibling connector still active, skipping shared station reset/logout")
+	} else {
+		c.log.DEBUG.Print("resetting charger to 3p")
+		c.Phases1p3p(3)
+		c.log.DEBUG.Printf("resetting charger current to %dA", maxCurrent)
+		c.MaxCurrent(maxCurrent)
+
+		// the reset writes above re-recorded this connector's requests;
+		// drop them again so they don't outlive the connector
+		c.session.unregisterConnector(c.connector)
+
+		c.logout()
+	}
+
+	c.cancel()
 
-	c.logout()
+	releaseAlfenSession(c.uri)
+}
+
+// SetReadDeadline overrides the deadline applied to future GETs, so a
+// caller driving AlfenHttp on a fixed tick can align the cutoff with its
+// own loop instead of the defaultRequestDeadline fallback.
+func (c *AlfenHttp) SetReadDeadline(t time.Time) {
+	c.readDeadline.set(t)
+}
+
+// SetWriteDeadline overrides the deadline applied to future POSTs, so a
+// caller driving AlfenHttp on a fixed tick can align the cutoff with its
+// own loop instead of the defaultRequestDeadline fallback.
+func (c *AlfenHttp) SetWriteDeadline(t time.Time) {
+	c.writeDeadline.set(t)
 }
 
 var _ api.Charger = (*AlfenHttp)(nil)
 
 func (c *AlfenHttp) Status() (api.ChargeStatus, error) {
-	value, err := c.getProperty(state)
+	value, err := c.getProperty(c.props().state)
 
 	status := api.StatusNone
 	if err == nil {
@@ -194,8 +563,15 @@ func (c *AlfenHttp) Enable(enable bool) error {
 	}
 }
 
+// MaxCurrent writes maxStationCurrent, a single station-wide register
+// shared by both sockets of a dual-socket Alfen. The value actually
+// written is arbitrated via the shared session so that one socket
+// lowering its demand (or disabling) doesn't cut off a sibling socket
+// that's still charging at a higher current.
 func (c *AlfenHttp) MaxCurrent(current int64) error {
-	return c.setProperty(maxStationCurrent, fmt.Sprint(current))
+	return c.session.writeCurrent(c.connector, current, func(combined int64) error {
+		return c.setProperty(maxStationCurrent, fmt.Sprint(combined))
+	})
 }
 
 var _ api.CurrentGetter = (*AlfenHttp)(nil)
@@ -213,7 +589,7 @@ func (c *AlfenHttp) GetMaxCurrent() (float64, error) {
 var _ api.PhaseGetter = (*AlfenHttp)(nil)
 
 func (c *AlfenHttp) GetPhases() (int, error) {
-	value, err := c.getProperty(connector1MaxAllowedPhases)
+	value, err := c.getProperty(c.props().maxAllowedPhases)
 
 	if err != nil {
 		return 0, err
@@ -236,20 +612,24 @@ func (c *AlfenHttp) CurrentPower() (float64, error) {
 
 var _ api.PhaseSwitcher = (*AlfenHttp)(nil)
 
+// Phases1p3p writes installationMaxAllowedPhases, a single station-wide
+// register shared by both sockets of a dual-socket Alfen. As with
+// MaxCurrent, the value actually written is arbitrated via the shared
+// session so one socket switching down to 1p doesn't force a sibling
+// socket that still needs 3p down with it.
 func (c *AlfenHttp) Phases1p3p(phases int) error {
-	err := c.setProperty(loadBalancingEnablePhaseSwitching, statusOn)
-
-	if err != nil {
-		return err
-	}
-
-	return c.setProperty(installationMaxAllowedPhases, fmt.Sprint(phases))
+	return c.session.writePhases(c.connector, phases, func(combined int) error {
+		return c.setProperties(map[string]string{
+			loadBalancingEnablePhaseSwitching: statusOn,
+			installationMaxAllowedPhases:      fmt.Sprint(combined),
+		})
+	})
 }
 
 var _ api.MeterEnergy = (*AlfenHttp)(nil)
 
 func (c *AlfenHttp) TotalEnergy() (float64, error) {
-	totalEnergy, err := c.getProperty(meterReadingSocket1)
+	totalEnergy, err := c.getProperty(c.props().meterReading)
 
 	if err != nil {
 		return 0, err
@@ -261,19 +641,19 @@ func (c *AlfenHttp) TotalEnergy() (float64, error) {
 var _ api.PhaseCurrents = (*AlfenHttp)(nil)
 
 func (c *AlfenHttp) Currents() (float64, float64, float64, error) {
-	currentL1, err := c.getProperty(currentL1Socket1)
+	currentL1, err := c.getProperty(c.props().currentL1)
 
 	if err != nil {
 		return 0, 0, 0, err
 	}
 
-	currentL2, err := c.getProperty(currentL2Socket1)
+	currentL2, err := c.getProperty(c.props().currentL2)
 
 	if err != nil {
 		return 0, 0, 0, err
 	}
 
-	currentL3, err := c.getProperty(currentL3Socket1)
+	currentL3, err := c.getProperty(c.props().currentL3)
 
 	if err != nil {
 		return 0, 0, 0, err
@@ -285,19 +665,19 @@ func (c *AlfenHttp) Currents() (float64, float64, float64, error) {
 var _ api.PhaseVoltages = (*AlfenHttp)(nil)
 
 func (c *AlfenHttp) Voltages() (float64, float64, float64, error) {
-	voltageL1, err := c.getProperty(voltageL1Socket1)
+	voltageL1, err := c.getProperty(c.props().voltageL1)
 
 	if err != nil {
 		return 0, 0, 0, err
 	}
 
-	voltageL2, err := c.getProperty(voltageL2Socket1)
+	voltageL2, err := c.getProperty(c.props().voltageL2)
 
 	if err != nil {
 		return 0, 0, 0, err
 	}
 
-	voltageL3, err := c.getProperty(voltageL3Socket1)
+	voltageL3, err := c.getProperty(c.props().voltageL3)
 
 	if err != nil {
 		return 0, 0, 0, err
@@ -306,6 +686,59 @@ func (c *AlfenHttp) Voltages() (float64, float64, float64, error) {
 	return voltageL1.(float64), voltageL2.(float64), voltageL3.(float64), nil
 }
 
+var _ api.Identifier = (*AlfenHttp)(nil)
+
+// Identify returns the UID of this socket's currently authorized RFID/
+// transaction tag, or an empty string if no session is active on it (or the
+// connector has no confirmed tag property - see connectorProps). 2053_0
+// reports the last used tag rather than clearing once the car unplugs, so
+// this is also gated on Status() - otherwise a departed driver's tag would
+// keep being reported indefinitely.
+func (c *AlfenHttp) Identify() (string, error) {
+	if c.props().tag == "" {
+		return "", nil
+	}
+
+	status, err := c.Status()
+	if err != nil {
+		return "", err
+	}
+
+	if status == api.StatusA {
+		return "", nil
+	}
+
+	value, err := c.getProperty(c.props().tag)
+	if err != nil {
+		return "", err
+	}
+
+	uid, ok := value.(string)
+	if !ok || uid == "" {
+		return "", nil
+	}
+
+	if decoded, err := hex.DecodeString(uid); err == nil && isPrintableASCII(decoded) {
+		return string(decoded), nil
+	}
+
+	return strings.ToUpper(uid), nil
+}
+
+func isPrintableASCII(b []byte) bool {
+	if len(b) == 0 {
+		return false
+	}
+
+	for _, c := range b {
+		if c < 0x20 || c > 0x7e {
+			return false
+		}
+	}
+
+	return true
+}
+
 var _ api.Diagnosis = (*AlfenHttp)(nil)
 
 func (c *AlfenHttp) Diagnose() {
@@ -330,8 +763,8 @@ func (c *AlfenHttp) Diagnose() {
 	}
 }
 
-func newInsecureClient(log *util.Logger) *http.Client {
-	tr := transport.Insecure()
+func newAlfenClient(log *util.Logger, tlsConfig *tls.Config) *http.Client {
+	tr := &http.Transport{TLSClientConfig: tlsConfig}
 
 	return &http.Client{
 		Timeout:   request.Timeout,
@@ -339,15 +772,88 @@ func newInsecureClient(log *util.Logger) *http.Client {
 	}
 }
 
-func newInsecureHelper(log *util.Logger) *request.Helper {
+func newAlfenHelper(log *util.Logger, tlsConfig *tls.Config) *request.Helper {
 	return &request.Helper{
-		Client: newInsecureClient(log),
+		Client: newAlfenClient(log, tlsConfig),
 	}
 }
 
+// get issues a GET cancellable via SetReadDeadline, replacing the embedded
+// request.Helper's Get so the request can be tied to c.ctx.
+func (c *AlfenHttp) get(url string) (*http.Response, error) {
+	ctx, cancel := c.readDeadline.arm(c.ctx)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	return c.doWithCancel(req, cancel)
+}
+
+// post issues a POST cancellable via SetWriteDeadline, replacing the
+// embedded request.Helper's Post so the request can be tied to c.ctx.
+func (c *AlfenHttp) post(url, contentType string, body io.Reader) (*http.Response, error) {
+	ctx, cancel := c.writeDeadline.arm(c.ctx)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, body)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	return c.doWithCancel(req, cancel)
+}
+
+// doWithCancel performs req and ties cancel's lifetime to the response
+// body instead of calling it here, since callers read the body after this
+// returns - closing the body (which every caller already defers) releases
+// the deadline context.
+func (c *AlfenHttp) doWithCancel(req *http.Request, cancel context.CancelFunc) (*http.Response, error) {
+	resp, err := c.Client.Do(req)
+	if err != nil {
+		cancel()
+		return nil, wrapAlfenTLSError(err)
+	}
+
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+
+	return resp, nil
+}
+
+// wrapAlfenTLSError points at CACert/InsecureSkipVerify when err looks like
+// the Alfen's self-signed certificate failing the verification that's now
+// the default (see buildAlfenTLSConfig). Every pre-upgrade config that
+// hadn't set either option used to talk to the wallbox happily and will
+// now fail here - without this, that regresses to a bare
+// "x509: certificate signed by unknown authority" with no pointer to the
+// config options that fix it.
+func wrapAlfenTLSError(err error) error {
+	var unknownAuthority x509.UnknownAuthorityError
+	if errors.As(err, &unknownAuthority) {
+		return fmt.Errorf("%w - set CACert to pin the Alfen's self-signed certificate, or InsecureSkipVerify: true to restore the previous behaviour", err)
+	}
+
+	return err
+}
+
+// cancelOnClose releases a deadlineTimer's context once the response body
+// it backs is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	defer c.cancel()
+	return c.ReadCloser.Close()
+}
+
 func (c *AlfenHttp) ensureAuthenticated(method func() (resp *http.Response, err error)) (*http.Response, error) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
+	c.session.mu.Lock()
+	defer c.session.mu.Unlock()
 
 	resp, err := method()
 
@@ -430,6 +936,11 @@ func (c *AlfenHttp) login() error {
 	c.log.TRACE.Println("start of login")
 	defer c.log.TRACE.Println("end of login")
 
+	if c.certAuth {
+		c.log.TRACE.Println("client certificate presented, skipping password login")
+		return nil
+	}
+
 	payload := new(bytes.Buffer)
 	encoder := json.NewEncoder(payload)
 	encoder.SetEscapeHTML(false)
@@ -440,7 +951,7 @@ func (c *AlfenHttp) login() error {
 	}
 	encoder.Encode(&login)
 
-	resp, err := c.Post(c.uri+"/api/login", alfenContentType, payload)
+	resp, err := c.post(c.uri+"/api/login", alfenContentType, payload)
 
 	if err != nil {
 		c.log.DEBUG.Printf("error during login: %v", err)
@@ -461,7 +972,12 @@ func (c *AlfenHttp) logout() error {
 	c.log.TRACE.Println("start of logout")
 	defer c.log.TRACE.Println("end of logout")
 
-	resp, err := c.Post(c.uri+"/api/logout", alfenContentType, nil)
+	if c.certAuth {
+		c.log.TRACE.Println("client certificate presented, skipping password logout")
+		return nil
+	}
+
+	resp, err := c.post(c.uri+"/api/logout", alfenContentType, nil)
 
 	if err != nil {
 		return err
@@ -482,7 +998,7 @@ func (c *AlfenHttp) getProperties() (*Properties, error) {
 	defer c.log.TRACE.Println("end of getProperties")
 
 	resp, err := c.ensureAuthenticated(func() (*http.Response, error) {
-		return c.Get(c.uri + "/api/prop?ids=" + strings.Join(readProps[:], ","))
+		return c.get(c.uri + "/api/prop?ids=" + strings.Join(c.readProps(), ","))
 	})
 
 	if err != nil {
@@ -526,13 +1042,23 @@ func (c *AlfenHttp) getProperty(id string) (interface{}, error) {
 }
 
 func (c *AlfenHttp) setProperty(property string, value string) error {
-	c.log.TRACE.Printf("start of setProperty %s to %s", property, value)
-	defer c.log.TRACE.Printf("end of setProperty %s to %s", property, value)
+	return c.setProperties(map[string]string{property: value})
+}
 
-	data := make(map[string]PropertyRequest)
-	data[property] = PropertyRequest{
-		Id:    property,
-		Value: value,
+// setProperties writes multiple properties in a single POST- /api/prop
+// already accepts a map keyed by property id, so callers that need to
+// change several properties in the same tick (e.g. Phases1p3p) can save a
+// round-trip by batching them here instead of calling setProperty per id.
+func (c *AlfenHttp) setProperties(properties map[string]string) error {
+	c.log.TRACE.Printf("start of setProperties %v", properties)
+	defer c.log.TRACE.Printf("end of setProperties %v", properties)
+
+	data := make(map[string]PropertyRequest, len(properties))
+	for property, value := range properties {
+		data[property] = PropertyRequest{
+			Id:    property,
+			Value: value,
+		}
 	}
 
 	jsonData, err := json.Marshal(data)
@@ -541,7 +1067,7 @@ func (c *AlfenHttp) setProperty(property string, value string) error {
 	}
 
 	resp, err := c.ensureAuthenticated(func() (*http.Response, error) {
-		return c.Post(c.uri+"/api/prop", alfenContentType, bytes.NewReader(jsonData))
+		return c.post(c.uri+"/api/prop", alfenContentType, bytes.NewReader(jsonData))
 	})
 
 	if err != nil {
@@ -558,7 +1084,7 @@ func (c *AlfenHttp) getInfo() (*Info, error) {
 	defer c.log.TRACE.Println("end of getInfo")
 
 	resp, err := c.ensureAuthenticated(func() (*http.Response, error) {
-		return c.Get(c.uri + "/api/info")
+		return c.get(c.uri + "/api/info")
 	})
 
 	if err != nil {